@@ -16,19 +16,31 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	cloudsqlapi "github.com/GoogleCloudPlatform/cloud-sql-proxy-operator/internal/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/cloud-sql-proxy-operator/internal/workload"
@@ -37,6 +49,25 @@ import (
 
 const finalizerName = cloudsqlapi.AnnotationPrefix + "/AuthProxyWorkload-finalizer"
 
+// impersonateServiceAccountAnnotation is recorded on each matched workload as
+// the GCP service account to impersonate. Unlike the workload identity
+// ServiceAccount binding, which this controller applies directly to
+// serviceAccountName, this controller only writes the annotation: it does not
+// add an --impersonate-service-account flag to the injected proxy container,
+// and nothing in this controller reads the annotation back.
+const impersonateServiceAccountAnnotation = cloudsqlapi.AnnotationPrefix + "/impersonate-service-account"
+
+// workloadIdentityServiceAccountName is the name of the ServiceAccount this
+// controller provisions in the workload's namespace when
+// Spec.AuthType == cloudsqlapi.AuthTypeWorkloadIdentity. It is derived from
+// Spec.GoogleServiceAccount, not the AuthProxyWorkload's own name, so that
+// multiple AuthProxyWorkload resources bound to the same GCP service account
+// share one k8s ServiceAccount instead of each provisioning their own.
+func workloadIdentityServiceAccountName(resource *cloudsqlapi.AuthProxyWorkload) string {
+	sum := sha256.Sum256([]byte(resource.Spec.GoogleServiceAccount))
+	return fmt.Sprintf("cloud-sql-proxy-%x", sum[:8])
+}
+
 var (
 	requeueNow       = ctrl.Result{Requeue: true}
 	requeueWithDelay = ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}
@@ -72,6 +103,12 @@ type AuthProxyWorkloadReconciler struct {
 	Scheme          *runtime.Scheme
 	recentlyDeleted *recentlyDeletedCache
 	updater         *workload.Updater
+	recorder        record.EventRecorder
+	// apiReader reads directly from the API server, bypassing the manager's
+	// cache. It exists only for the NotFound race handled in Reconcile: the
+	// cached client can report NotFound while the object is still live on
+	// the server because its informer hasn't caught up yet.
+	apiReader client.Reader
 }
 
 // NewAuthProxyWorkloadManager constructs an AuthProxyWorkloadReconciler
@@ -81,17 +118,148 @@ func NewAuthProxyWorkloadReconciler(mgr ctrl.Manager, u *workload.Updater) (*Aut
 		Scheme:          mgr.GetScheme(),
 		recentlyDeleted: &recentlyDeletedCache{},
 		updater:         u,
+		recorder:        mgr.GetEventRecorderFor("authproxyworkload-controller"),
+		apiReader:       mgr.GetAPIReader(),
 	}
 	err := r.SetupWithManager(mgr)
 	return r, err
 }
 
 // SetupWithManager adds this AuthProxyWorkload controller to the controller-runtime
-// manager.
+// manager. In addition to watching AuthProxyWorkload resources directly, it
+// watches every workload kind that can be a target of a WorkloadSelectorSpec,
+// so that a matching workload which is created, updated, or rolled out after
+// its AuthProxyWorkload already exists gets re-reconciled instead of only
+// being decorated when the admission webhook happens to see it.
 func (r *AuthProxyWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&cloudsqlapi.AuthProxyWorkload{}).
-		Complete(r)
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&cloudsqlapi.AuthProxyWorkload{})
+
+	for _, kind := range workload.WatchableKinds {
+		wl, err := workload.WorkloadForKind(kind)
+		if err != nil {
+			return err
+		}
+		bldr = bldr.Watches(
+			wl.Object(),
+			handler.EnqueueRequestsFromMapFunc(r.findAuthProxyWorkloadsForWorkload),
+			builder.WithPredicates(workloadChangePredicate()),
+		)
+	}
+
+	return bldr.Complete(r)
+}
+
+// workloadChangePredicate filters out events that cannot possibly change the
+// outcome of reconciling a workload, so that the watches added in
+// SetupWithManager don't trigger a tight reconcile loop from cache-vs-write
+// races or from unrelated workload changes.
+func workloadChangePredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		DeleteFunc: func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion() {
+				return false
+			}
+			return labelsChanged(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) ||
+				templateChanged(e.ObjectOld, e.ObjectNew) ||
+				annotationsChanged(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations())
+		},
+	}
+}
+
+// findAuthProxyWorkloadsForWorkload maps a workload event back to the
+// AuthProxyWorkload resources whose WorkloadSelectorSpec matches it, so that
+// those resources are requeued for reconciliation. AuthProxyWorkload is
+// listed cluster-wide, not scoped to the workload's namespace, because
+// WorkloadSelectorSpec.Namespace lets an AuthProxyWorkload target a workload
+// in a different namespace than its own - the same cross-namespace case
+// listWorkloads already supports.
+func (r *AuthProxyWorkloadReconciler) findAuthProxyWorkloadsForWorkload(ctx context.Context, obj client.Object) []ctrl.Request {
+	l := log.FromContext(ctx)
+
+	var list cloudsqlapi.AuthProxyWorkloadList
+	if err := r.List(ctx, &list); err != nil {
+		l.Error(err, "unable to list AuthProxyWorkload while mapping workload event", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var reqs []ctrl.Request
+	for i := range list.Items {
+		apw := &list.Items[i]
+		if workloadMatchesSelector(obj, apw.Spec.Workload, apw.GetNamespace()) {
+			reqs = append(reqs, ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: apw.GetNamespace(),
+				Name:      apw.GetName(),
+			}})
+		}
+	}
+	return reqs
+}
+
+// workloadMatchesSelector reports whether obj is matched by name or by label
+// selector under workloadSelector, using the same namespace, name and label
+// rules listWorkloads uses. Unlike listWorkloads, it does not compare
+// workloadSelector.Kind, so a workload of another kind with a matching name
+// or labels also matches.
+// apwNamespace is the AuthProxyWorkload's own namespace, used as the default
+// target namespace when workloadSelector.Namespace is unset.
+func workloadMatchesSelector(obj client.Object, workloadSelector cloudsqlapi.WorkloadSelectorSpec, apwNamespace string) bool {
+	ns := apwNamespace
+	if workloadSelector.Namespace != "" {
+		ns = workloadSelector.Namespace
+	}
+	if obj.GetNamespace() != ns {
+		return false
+	}
+
+	if workloadSelector.Name != "" {
+		return obj.GetName() == workloadSelector.Name
+	}
+
+	sel, err := workloadSelector.LabelsSelector()
+	if err != nil {
+		return false
+	}
+	return sel.Matches(labels.Set(obj.GetLabels()))
+}
+
+// annotationsChanged reports whether any cloudsql.cloud.google.com annotation
+// differs between old and new.
+func annotationsChanged(old, new map[string]string) bool {
+	for k, v := range new {
+		if !strings.HasPrefix(k, cloudsqlapi.AnnotationPrefix) {
+			continue
+		}
+		if old[k] != v {
+			return true
+		}
+	}
+	for k := range old {
+		if !strings.HasPrefix(k, cloudsqlapi.AnnotationPrefix) {
+			continue
+		}
+		if _, ok := new[k]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// labelsChanged reports whether the set of labels differs between old and new.
+func labelsChanged(old, new map[string]string) bool {
+	return !reflect.DeepEqual(old, new)
+}
+
+// templateChanged reports whether the workload's generation advanced, which
+// is how the apiserver signals that spec.template (or, for a bare Pod, the
+// pod's own spec) changed.
+func templateChanged(oldObj, newObj client.Object) bool {
+	return oldObj.GetGeneration() != newObj.GetGeneration()
 }
 
 //+kubebuilder:rbac:groups=apps,resources=*,verbs=get;list;watch
@@ -137,6 +305,23 @@ func (r *AuthProxyWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			return ctrl.Result{}, nil
 		}
 
+		// The cached client reports the resource gone even though we never
+		// saw it go through doDelete() above. Before giving up, check the API
+		// server directly: if the cache is merely lagging behind a delete
+		// event, the object can still be live there with our finalizer still
+		// attached, and leaving it alone forever is exactly the kind of wedge
+		// that can block deletion of the rest of the namespace. If it's
+		// really gone there too, there's nothing left to patch.
+		if errors.IsNotFound(err) {
+			if patchErr := r.forceRemoveFinalizerIfLiveOnServer(ctx, l, req.NamespacedName); patchErr != nil {
+				l.Error(patchErr, "unable to force-remove finalizer after cache NotFound", "name", req.NamespacedName)
+				return requeueWithDelay, patchErr
+			}
+			l.Info("AuthProxyWorkload not found, treating as already deleted", "name", req.NamespacedName)
+			r.recentlyDeleted.set(req.NamespacedName, true)
+			return ctrl.Result{}, nil
+		}
+
 		// otherwise, report the error and requeue. This is likely caused by a delay
 		// in reaching consistency in the eventually-consistent kubernetes API.
 		l.Error(err, "unable to fetch resource")
@@ -166,16 +351,91 @@ func (r *AuthProxyWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	return r.doCreateUpdate(ctx, l, resource)
 }
 
+// forceRemoveFinalizerIfLiveOnServer handles the inverse of the usual delete
+// race: the manager's cached client returned NotFound for name, but an
+// uncached read straight from the API server shows the object is still
+// there, marked for deletion, with finalizerName attached. That means the
+// informer cache is lagging behind a delete that already happened
+// server-side, so this does a one-shot patch to remove the finalizer directly
+// instead of waiting for the cache to catch up, which could otherwise wedge
+// deletion of the rest of the namespace. If the uncached read also comes
+// back NotFound, there is genuinely nothing left to patch and this is a
+// no-op.
+func (r *AuthProxyWorkloadReconciler) forceRemoveFinalizerIfLiveOnServer(ctx context.Context, l logr.Logger, name types.NamespacedName) error {
+	live := &cloudsqlapi.AuthProxyWorkload{}
+	if err := r.apiReader.Get(ctx, name, live); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if live.GetDeletionTimestamp().IsZero() || !controllerutil.ContainsFinalizer(live, finalizerName) {
+		return nil
+	}
+
+	orig := live.DeepCopy()
+	controllerutil.RemoveFinalizer(live, finalizerName)
+	if err := r.Patch(ctx, live, client.MergeFrom(orig)); err != nil {
+		return err
+	}
+	l.Info("Force-removed finalizer via uncached one-shot patch", "name", name)
+	return nil
+}
+
 // doDelete removes our finalizer and updates the related workloads
 // when the reconcile loop receives an AuthProxyWorkload that was deleted.
 func (r *AuthProxyWorkloadReconciler) doDelete(ctx context.Context, resource *cloudsqlapi.AuthProxyWorkload, l logr.Logger) (ctrl.Result, error) {
+	orig := resource.DeepCopy()
+
+	// Whether this resource had any live dependents recorded as of the last
+	// successful reconcile, before updateWorkloadStatus below overwrites
+	// WorkloadStatus with the current (possibly now-empty) matching set.
+	hadDependents := len(resource.Status.WorkloadStatus) > 0
 
 	// Mark all related workloads as needing to be updated
-	_, err := r.updateWorkloadStatus(ctx, l, resource)
+	matching, err := r.updateWorkloadStatus(ctx, l, resource)
 	if err != nil {
 		return requeueNow, err
 	}
 
+	// Unconditional, not gated on the current Spec.AuthType: the
+	// ServiceAccount this provisions is named from Spec.GoogleServiceAccount,
+	// which survives an AuthType change, so a resource that was workload
+	// identity at some point and later switched to impersonate before being
+	// deleted still needs this cleanup. cleanupWorkloadIdentityBinding is a
+	// no-op (IsNotFound) when there was never a ServiceAccount to begin with.
+	if err = r.cleanupWorkloadIdentityBinding(ctx, l, resource); err != nil {
+		return requeueNow, err
+	}
+
+	// Force the finalizer off immediately, as a safety net, only when
+	// dependents that used to be tracked have disappeared out from under us -
+	// either nothing matches the selector any more, or everything that does
+	// is itself terminating. A resource that never had any recorded
+	// dependents is just an ordinary, unremarkable deletion, not a recovery
+	// case, so it's deliberately excluded here to avoid mislabeling it as
+	// one - the generic finalizer removal below handles that case instead.
+	// This is a genuinely distinct path, not just a condition/event stapled
+	// onto the same removal: it returns as soon as the finalizer is gone,
+	// rather than falling into the generic removal below.
+	if hadDependents && (len(matching) == 0 || allWorkloadsTerminating(matching)) {
+		r.recordFinalizerForceRemoved(resource)
+		// Persist the condition before removing the finalizer: once the
+		// finalizer list goes empty the API server deletes the object
+		// immediately, and a status patch against an object that's already
+		// gone would just fail.
+		if err := r.patchAuthProxyWorkloadStatus(ctx, resource, orig); err != nil {
+			l.Error(err, "unable to patch status while force-releasing finalizer", "AuthProxyWorkload", resource.GetNamespace()+"/"+resource.GetName())
+			return requeueNow, err
+		}
+		controllerutil.RemoveFinalizer(resource, finalizerName)
+		if err := r.Update(ctx, resource); err != nil {
+			return requeueNow, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Remove the finalizer so that the object can be fully deleted
 	if controllerutil.ContainsFinalizer(resource, finalizerName) {
 		controllerutil.RemoveFinalizer(resource, finalizerName)
@@ -188,6 +448,34 @@ func (r *AuthProxyWorkloadReconciler) doDelete(ctx context.Context, resource *cl
 	return ctrl.Result{}, nil
 }
 
+// allWorkloadsTerminating reports whether every workload in matching already
+// has a DeletionTimestamp set, meaning none of them can still depend on this
+// AuthProxyWorkload's annotations.
+func allWorkloadsTerminating(matching []workload.Workload) bool {
+	for _, wl := range matching {
+		if wl.Object().GetDeletionTimestamp().IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// recordFinalizerForceRemoved records an event and condition noting that the
+// finalizer is being removed as a safety net rather than through the normal
+// path, so operators can tell this happened instead of silently losing track
+// of an orphaned AuthProxyWorkload.
+func (r *AuthProxyWorkloadReconciler) recordFinalizerForceRemoved(resource *cloudsqlapi.AuthProxyWorkload) {
+	resource.Status.Conditions = replaceCondition(resource.Status.Conditions, &metav1.Condition{
+		Type:               cloudsqlapi.ConditionUpToDate,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: resource.GetGeneration(),
+		Reason:             cloudsqlapi.ReasonFinalizerForceRemoved,
+		Message:            "No live dependent workloads found; finalizer force-removed",
+	})
+	r.recorder.Event(resource, corev1.EventTypeNormal, cloudsqlapi.ReasonFinalizerForceRemoved,
+		"No live dependent workloads found; removing finalizer so deletion is not blocked")
+}
+
 // doCreateUpdate reconciles an AuthProxyWorkload resource that has been created
 // or updated, making sure that related workloads get updated.
 //
@@ -203,14 +491,16 @@ func (r *AuthProxyWorkloadReconciler) doDelete(ctx context.Context, resource *cl
 // | 0       | *        | *         | *            | start                     |
 // | 1.1     | absent   | *         | *            | needs finalizer           |
 // | 1.2     | present  | error     | *            | can't list workloads      |
+// | 1.5     | present  | nil       | unchanged    | already up to date        |
 // | 2.1     | present  | nil       | == 0         | no workloads to reconcile |
 // | 3.1     | present  | nil       | > 0          | workloads reconciled      |
 //
 //	start ---x
 //	          \---> 1.1 --> (requeue, goto start)
 //	           \---> 1.2 --> (requeue, goto start)
-//	            \---> 2.1 --> (end)
-//	             \---> 3.1 --> (end)
+//	            \---> 1.5 --> (end)
+//	             \---> 2.1 --> (end)
+//	              \---> 3.1 --> (end)
 func (r *AuthProxyWorkloadReconciler) doCreateUpdate(ctx context.Context, l logr.Logger, resource *cloudsqlapi.AuthProxyWorkload) (ctrl.Result, error) {
 	orig := resource.DeepCopy()
 	var err error
@@ -224,12 +514,90 @@ func (r *AuthProxyWorkloadReconciler) doCreateUpdate(ctx context.Context, l logr
 		return r.applyFinalizer(ctx, l, resource)
 	}
 
+	// State 1.5: Nothing about this resource or its matching workloads has
+	// changed since the last reconcile that finished successfully, so there
+	// is no reason to do any further work. This must run before the
+	// workload-identity and Placement checks below, or an unchanged resource
+	// still pays for a ServiceAccount Get/Update (and any other API calls
+	// those checks make) on every single reconcile.
+	matchingForHash, err := r.listWorkloads(ctx, resource.Spec.Workload, resource.GetNamespace())
+	if err != nil {
+		return requeueWithDelay, err
+	}
+	newHash := computeWorkloadHash(matchingForHash)
+	upToDate := findCondition(resource.Status.Conditions, cloudsqlapi.ConditionUpToDate)
+	if resource.GetGeneration() == resource.Status.ObservedGeneration &&
+		newHash == resource.Status.ObservedWorkloadHash &&
+		upToDate != nil && upToDate.Status == metav1.ConditionTrue &&
+		upToDate.ObservedGeneration == resource.GetGeneration() {
+		return ctrl.Result{}, nil
+	}
+
+	wasWorkloadIdentityBound := false
+	if c := findCondition(resource.Status.Conditions, cloudsqlapi.ConditionWorkloadIdentityBound); c != nil {
+		wasWorkloadIdentityBound = c.Status == metav1.ConditionTrue
+	}
+
+	switch resource.Spec.AuthType {
+	case cloudsqlapi.AuthTypeWorkloadIdentity:
+		if err = r.ensureWorkloadIdentityBinding(ctx, l, resource, matchingForHash); err != nil {
+			l.Error(err, "unable to bind workload identity", "AuthProxyWorkload", resource.GetNamespace()+"/"+resource.GetName())
+			return requeueWithDelay, err
+		}
+	default:
+		// AuthType is no longer workload-identity. If a previous reconcile
+		// bound one, tear it down here instead of waiting for this resource
+		// to be deleted - otherwise the ServiceAccount and the
+		// serviceAccountName left on matched workloads are orphaned for as
+		// long as this AuthProxyWorkload keeps existing under its new
+		// AuthType.
+		if wasWorkloadIdentityBound {
+			if err = r.teardownWorkloadIdentityBinding(ctx, l, resource, matchingForHash); err != nil {
+				l.Error(err, "unable to tear down workload identity binding", "AuthProxyWorkload", resource.GetNamespace()+"/"+resource.GetName())
+				return requeueWithDelay, err
+			}
+		}
+		if resource.Spec.AuthType == cloudsqlapi.AuthTypeImpersonate {
+			if err = r.ensureImpersonateAnnotation(ctx, resource, matchingForHash); err != nil {
+				l.Error(err, "unable to annotate impersonate service account", "AuthProxyWorkload", resource.GetNamespace()+"/"+resource.GetName())
+				return requeueWithDelay, err
+			}
+		}
+	}
+
+	if resource.Spec.Placement != nil {
+		// Placement is checked here, at reconcile time, for conflicts with
+		// the required node affinity of the matching workloads; a conflict
+		// sets PlacementApplied to False. This controller does not write
+		// Placement into the matching workloads or their annotations.
+		if conflict, msg := placementConflicts(resource.Spec.Placement, matchingForHash); conflict {
+			resource.Status.Conditions = replaceCondition(resource.Status.Conditions, &metav1.Condition{
+				Type:               cloudsqlapi.ConditionPlacementApplied,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: resource.GetGeneration(),
+				Reason:             cloudsqlapi.ReasonPlacementConflict,
+				Message:            msg,
+			})
+			return r.reconcileResult(ctx, l, resource, orig, cloudsqlapi.ReasonPlacementConflict, msg)
+		}
+
+		resource.Status.Conditions = replaceCondition(resource.Status.Conditions, &metav1.Condition{
+			Type:               cloudsqlapi.ConditionPlacementApplied,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: resource.GetGeneration(),
+			Reason:             cloudsqlapi.ReasonPlacementApplied,
+			Message:            "Placement does not conflict with the required node affinity of matching workloads",
+		})
+	}
+
 	// find all workloads that relate to this AuthProxyWorkload resource
 	allWorkloads, err := r.updateWorkloadStatus(ctx, l, resource)
 	if err != nil {
 		// State 1.2 - unable to read workloads, abort and try again after a delay.
 		return requeueWithDelay, err
 	}
+	resource.Status.ObservedGeneration = resource.GetGeneration()
+	resource.Status.ObservedWorkloadHash = newHash
 
 	// State 2: If workload reconcile has not yet started, then start it.
 
@@ -288,6 +656,167 @@ func (r *AuthProxyWorkloadReconciler) applyFinalizer(
 	return requeueNow, err
 }
 
+// ensureWorkloadIdentityBinding provisions the k8s side of GCP Workload
+// Identity: a ServiceAccount in the workload's namespace annotated so that
+// it is bound to resource.Spec.GoogleServiceAccount, then sets that
+// ServiceAccount's name directly on each matched workload's
+// spec.template.spec.serviceAccountName. This is done directly by the
+// reconciler, by updating each workload, not via an annotation.
+func (r *AuthProxyWorkloadReconciler) ensureWorkloadIdentityBinding(ctx context.Context, l logr.Logger, resource *cloudsqlapi.AuthProxyWorkload, matching []workload.Workload) error {
+	sa := &corev1.ServiceAccount{}
+	key := types.NamespacedName{Namespace: resource.GetNamespace(), Name: workloadIdentityServiceAccountName(resource)}
+
+	err := r.Get(ctx, key, sa)
+	if errors.IsNotFound(err) {
+		sa = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Annotations: map[string]string{
+					"iam.gke.io/gcp-service-account": resource.Spec.GoogleServiceAccount,
+				},
+			},
+		}
+		if err = r.Create(ctx, sa); err != nil {
+			return fmt.Errorf("unable to create workload identity ServiceAccount %s: %v", key, err)
+		}
+		l.Info("Created workload identity ServiceAccount", "name", key.Name, "namespace", key.Namespace)
+	} else if err != nil {
+		return fmt.Errorf("unable to get workload identity ServiceAccount %s: %v", key, err)
+	} else if sa.Annotations["iam.gke.io/gcp-service-account"] != resource.Spec.GoogleServiceAccount {
+		sa.Annotations["iam.gke.io/gcp-service-account"] = resource.Spec.GoogleServiceAccount
+		if err = r.Update(ctx, sa); err != nil {
+			return fmt.Errorf("unable to update workload identity ServiceAccount %s: %v", key, err)
+		}
+	}
+
+	for _, wl := range matching {
+		ps := wl.PodSpec()
+		if ps == nil || ps.ServiceAccountName == key.Name {
+			continue
+		}
+		ps.ServiceAccountName = key.Name
+		if err := r.Update(ctx, wl.Object()); err != nil {
+			o := wl.Object()
+			return fmt.Errorf("unable to set workload identity service account on %s/%s: %v", o.GetNamespace(), o.GetName(), err)
+		}
+
+		s := newStatus(wl)
+		s.Conditions = replaceCondition(s.Conditions, &metav1.Condition{
+			Type:               cloudsqlapi.ConditionWorkloadIdentityBound,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: resource.GetGeneration(),
+			Reason:             cloudsqlapi.ReasonWorkloadIdentityBound,
+			Message:            fmt.Sprintf("serviceAccountName set to %s", key.Name),
+		})
+		resource.Status.WorkloadStatus = replaceStatus(resource.Status.WorkloadStatus, s)
+	}
+
+	resource.Status.Conditions = replaceCondition(resource.Status.Conditions, &metav1.Condition{
+		Type:               cloudsqlapi.ConditionWorkloadIdentityBound,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: resource.GetGeneration(),
+		Reason:             cloudsqlapi.ReasonWorkloadIdentityBound,
+		Message:            fmt.Sprintf("Bound to GCP service account %s", resource.Spec.GoogleServiceAccount),
+	})
+
+	return nil
+}
+
+// teardownWorkloadIdentityBinding undoes ensureWorkloadIdentityBinding on each
+// matched workload and removes the provisioned ServiceAccount, for when
+// Spec.AuthType has changed away from AuthTypeWorkloadIdentity since the last
+// reconcile that bound it.
+func (r *AuthProxyWorkloadReconciler) teardownWorkloadIdentityBinding(ctx context.Context, l logr.Logger, resource *cloudsqlapi.AuthProxyWorkload, matching []workload.Workload) error {
+	saName := workloadIdentityServiceAccountName(resource)
+
+	for _, wl := range matching {
+		ps := wl.PodSpec()
+		if ps == nil || ps.ServiceAccountName != saName {
+			continue
+		}
+		ps.ServiceAccountName = ""
+		if err := r.Update(ctx, wl.Object()); err != nil {
+			o := wl.Object()
+			return fmt.Errorf("unable to clear workload identity service account from %s/%s: %v", o.GetNamespace(), o.GetName(), err)
+		}
+	}
+
+	if err := r.cleanupWorkloadIdentityBinding(ctx, l, resource); err != nil {
+		return err
+	}
+
+	resource.Status.Conditions = replaceCondition(resource.Status.Conditions, &metav1.Condition{
+		Type:               cloudsqlapi.ConditionWorkloadIdentityBound,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: resource.GetGeneration(),
+		Reason:             cloudsqlapi.ReasonAuthTypeChanged,
+		Message:            "AuthType changed away from workload-identity; binding removed",
+	})
+	return nil
+}
+
+// ensureImpersonateAnnotation annotates each matched workload with the GCP
+// service account to impersonate, for AuthTypeImpersonate. See
+// impersonateServiceAccountAnnotation's doc comment: nothing in this
+// controller reads it, so no condition here claims impersonation is actually applied to the
+// injected proxy.
+func (r *AuthProxyWorkloadReconciler) ensureImpersonateAnnotation(ctx context.Context, resource *cloudsqlapi.AuthProxyWorkload, matching []workload.Workload) error {
+	for _, wl := range matching {
+		o := wl.Object()
+		if o.GetAnnotations()[impersonateServiceAccountAnnotation] == resource.Spec.GoogleServiceAccount {
+			continue
+		}
+		annotations := o.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[impersonateServiceAccountAnnotation] = resource.Spec.GoogleServiceAccount
+		o.SetAnnotations(annotations)
+		if err := r.Update(ctx, o); err != nil {
+			return fmt.Errorf("unable to annotate %s/%s with impersonate service account: %v", o.GetNamespace(), o.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// cleanupWorkloadIdentityBinding removes the ServiceAccount created by
+// ensureWorkloadIdentityBinding, but only when no other AuthProxyWorkload in
+// the namespace still references it.
+func (r *AuthProxyWorkloadReconciler) cleanupWorkloadIdentityBinding(ctx context.Context, l logr.Logger, resource *cloudsqlapi.AuthProxyWorkload) error {
+	var list cloudsqlapi.AuthProxyWorkloadList
+	if err := r.List(ctx, &list, client.InNamespace(resource.GetNamespace())); err != nil {
+		return fmt.Errorf("unable to list AuthProxyWorkload while cleaning up workload identity binding: %v", err)
+	}
+
+	saName := workloadIdentityServiceAccountName(resource)
+	for i := range list.Items {
+		other := &list.Items[i]
+		if other.GetName() == resource.GetName() {
+			continue
+		}
+		if other.Spec.AuthType == cloudsqlapi.AuthTypeWorkloadIdentity && workloadIdentityServiceAccountName(other) == saName {
+			// Another resource still owns this ServiceAccount name; leave it in place.
+			return nil
+		}
+	}
+
+	sa := &corev1.ServiceAccount{}
+	key := types.NamespacedName{Namespace: resource.GetNamespace(), Name: saName}
+	if err := r.Get(ctx, key, sa); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get workload identity ServiceAccount %s: %v", key, err)
+	}
+
+	if err := r.Delete(ctx, sa); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete workload identity ServiceAccount %s: %v", key, err)
+	}
+	l.Info("Deleted workload identity ServiceAccount", "name", key.Name, "namespace", key.Namespace)
+	return nil
+}
+
 // patchAuthProxyWorkloadStatus uses the PATCH method to incrementally update
 // the AuthProxyWorkload.Status field.
 func (r *AuthProxyWorkloadReconciler) patchAuthProxyWorkloadStatus(
@@ -316,8 +845,10 @@ func (r *AuthProxyWorkloadReconciler) updateWorkloadStatus(ctx context.Context,
 
 	// all matching workloads get a new annotation that will be removed
 	// when the reconcile loop for outOfDate is completed.
+	matchedKeys := make(map[string]bool, len(matching))
 	for _, wl := range matching {
 		s := newStatus(wl)
+		matchedKeys[workloadStatusKey(s)] = true
 		s.Conditions = replaceCondition(s.Conditions, &metav1.Condition{
 			Type:               cloudsqlapi.ConditionWorkloadUpToDate,
 			Status:             metav1.ConditionTrue,
@@ -328,9 +859,33 @@ func (r *AuthProxyWorkloadReconciler) updateWorkloadStatus(ctx context.Context,
 		resource.Status.WorkloadStatus = replaceStatus(resource.Status.WorkloadStatus, s)
 	}
 
+	// Drop any WorkloadStatus entries left over from a workload that no
+	// longer matches (relabeled, deleted, selector changed), so WorkloadStatus
+	// reflects only current dependents. Without this, an entry recorded once
+	// lingers forever, which is what made doDelete's "did this resource ever
+	// have live dependents" check permanently true.
+	resource.Status.WorkloadStatus = pruneWorkloadStatus(resource.Status.WorkloadStatus, matchedKeys)
+
 	return matching, nil
 }
 
+// workloadStatusKey identifies a WorkloadStatus entry by the same fields
+// replaceStatus uses to match one.
+func workloadStatusKey(s *cloudsqlapi.WorkloadStatus) string {
+	return s.Kind + "/" + s.Version + "/" + s.Namespace + "/" + s.Name
+}
+
+// pruneWorkloadStatus removes entries from statuses whose key is not in keep.
+func pruneWorkloadStatus(statuses []*cloudsqlapi.WorkloadStatus, keep map[string]bool) []*cloudsqlapi.WorkloadStatus {
+	kept := statuses[:0]
+	for _, s := range statuses {
+		if keep[workloadStatusKey(s)] {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
 // replaceStatus replace a status with the same name, namespace, kind, and version,
 // or appends updatedStatus to statuses
 func replaceStatus(statuses []*cloudsqlapi.WorkloadStatus, updatedStatus *cloudsqlapi.WorkloadStatus) []*cloudsqlapi.WorkloadStatus {
@@ -395,6 +950,150 @@ func newStatus(wl workload.Workload) *cloudsqlapi.WorkloadStatus {
 	}
 }
 
+// computeWorkloadHash returns a sha256 hash of the sorted
+// "Kind/Namespace/Name/ResourceVersion" identity of each matching workload.
+// Comparing this hash across reconciles is how doCreateUpdate detects that
+// the set of workloads - and whether any of them changed - is identical to
+// the last reconcile, so it can skip redundant status patches and annotation
+// writes.
+func computeWorkloadHash(matching []workload.Workload) string {
+	ids := make([]string, 0, len(matching))
+	for _, wl := range matching {
+		o := wl.Object()
+		ids = append(ids, fmt.Sprintf("%s/%s/%s/%s",
+			o.GetObjectKind().GroupVersionKind().Kind, o.GetNamespace(), o.GetName(), o.GetResourceVersion()))
+	}
+	sort.Strings(ids)
+
+	h := sha256.New()
+	for _, id := range ids {
+		h.Write([]byte(id))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// placementConflicts reports whether placement's required node affinity is
+// mutually exclusive with the required node affinity any of matching's
+// workloads already declares for itself - not merely whether both happen to
+// specify one, since two required node affinities can easily both be
+// satisfied by the same node. They only conflict when no node could ever
+// satisfy both: every combination of their OR'd NodeSelectorTerms contains a
+// same-key requirement whose values can't overlap.
+func placementConflicts(placement *cloudsqlapi.PlacementSpec, matching []workload.Workload) (bool, string) {
+	placementTerms := requiredNodeSelectorTerms(placement.Affinity)
+	if len(placementTerms) == 0 {
+		return false, ""
+	}
+
+	for _, wl := range matching {
+		ps := wl.PodSpec()
+		if ps == nil {
+			continue
+		}
+		existingTerms := requiredNodeSelectorTerms(ps.Affinity)
+		if len(existingTerms) == 0 {
+			continue
+		}
+		if nodeSelectorTermsConflict(existingTerms, placementTerms) {
+			o := wl.Object()
+			return true, fmt.Sprintf(
+				"Spec.Placement.Affinity.NodeAffinity can never be satisfied together with the required node affinity already set on %s/%s",
+				o.GetNamespace(), o.GetName())
+		}
+	}
+
+	return false, ""
+}
+
+// requiredNodeSelectorTerms returns affinity's required-during-scheduling
+// node selector terms, or nil if affinity declares none.
+func requiredNodeSelectorTerms(affinity *corev1.Affinity) []corev1.NodeSelectorTerm {
+	if affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+	return affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+}
+
+// nodeSelectorTermsConflict reports whether every term in a conflicts with
+// every term in b. NodeSelectorTerms within a slice are OR'd together, so a
+// single compatible (a, b) pair is enough to make the whole requirement
+// satisfiable by some node, meaning there is no real conflict.
+func nodeSelectorTermsConflict(a, b []corev1.NodeSelectorTerm) bool {
+	for _, ta := range a {
+		for _, tb := range b {
+			if !nodeSelectorTermConflicts(ta, tb) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nodeSelectorTermConflicts reports whether two NodeSelectorTerms, whose
+// MatchExpressions are AND'd together within each term, can never both be
+// satisfied by the same node.
+func nodeSelectorTermConflicts(a, b corev1.NodeSelectorTerm) bool {
+	for _, ea := range a.MatchExpressions {
+		for _, eb := range b.MatchExpressions {
+			if ea.Key == eb.Key && nodeSelectorRequirementsConflict(ea, eb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nodeSelectorRequirementsConflict reports whether two requirements on the
+// same label key can never both be true for a single value of that key. Only
+// the In/In, In/NotIn and Exists/DoesNotExist pairings are recognized; any
+// other pairing, including In/DoesNotExist and any use of Gt or Lt, is
+// reported as not conflicting.
+func nodeSelectorRequirementsConflict(a, b corev1.NodeSelectorRequirement) bool {
+	switch {
+	case a.Operator == corev1.NodeSelectorOpIn && b.Operator == corev1.NodeSelectorOpIn:
+		return !stringSlicesIntersect(a.Values, b.Values)
+	case a.Operator == corev1.NodeSelectorOpIn && b.Operator == corev1.NodeSelectorOpNotIn:
+		return stringSliceSubset(a.Values, b.Values)
+	case a.Operator == corev1.NodeSelectorOpNotIn && b.Operator == corev1.NodeSelectorOpIn:
+		return stringSliceSubset(b.Values, a.Values)
+	case a.Operator == corev1.NodeSelectorOpExists && b.Operator == corev1.NodeSelectorOpDoesNotExist,
+		a.Operator == corev1.NodeSelectorOpDoesNotExist && b.Operator == corev1.NodeSelectorOpExists:
+		return true
+	default:
+		return false
+	}
+}
+
+// stringSlicesIntersect reports whether a and b share any element.
+func stringSlicesIntersect(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = true
+	}
+	for _, v := range b {
+		if set[v] {
+			return true
+		}
+	}
+	return false
+}
+
+// stringSliceSubset reports whether every element of subset appears in superset.
+func stringSliceSubset(subset, superset []string) bool {
+	set := make(map[string]bool, len(superset))
+	for _, v := range superset {
+		set[v] = true
+	}
+	for _, v := range subset {
+		if !set[v] {
+			return false
+		}
+	}
+	return true
+}
+
 // listWorkloads produces a list of Workload's that match the WorkloadSelectorSpec
 // in the specified namespace.
 func (r *AuthProxyWorkloadReconciler) listWorkloads(ctx context.Context, workloadSelector cloudsqlapi.WorkloadSelectorSpec, ns string) ([]workload.Workload, error) {